@@ -0,0 +1,119 @@
+package gomonad
+
+import "github.com/gilramir/gomonad/maybepkg"
+
+// Scope holds the named intermediate values accumulated by a Do chain,
+// keyed by the name each Bind step was given.
+type Scope map[string]any
+
+// DoResult sequences Result-producing steps without nesting BindResult
+// calls. Each step runs against the Scope accumulated so far and stores
+// its value under name; the whole chain short-circuits on the first
+// error.
+type DoResult[T any] struct {
+	scope Scope
+	err   error
+}
+
+// NewDoResult starts a new DoResult chain with an empty Scope.
+func NewDoResult[T any]() *DoResult[T] {
+	return &DoResult[T]{scope: Scope{}}
+}
+
+// Bind runs f against the Scope accumulated so far and stores its result
+// under name, unless an earlier step has already failed.
+func (d *DoResult[T]) Bind(name string, f func(Scope) Result[any]) *DoResult[T] {
+	if d.err != nil {
+		return d
+	}
+	r := f(d.scope)
+	if r.IsErr() {
+		d.err = r.GetErr()
+		return d
+	}
+	d.scope[name] = r.Get()
+	return d
+}
+
+// Return finishes the chain, applying f to the final Scope if every step
+// succeeded, or propagating the first error encountered otherwise.
+func (d *DoResult[T]) Return(f func(Scope) T) Result[T] {
+	if d.err != nil {
+		return Err[T](d.err)
+	}
+	return Ok(f(d.scope))
+}
+
+// DoMaybe sequences Maybe-producing steps without nesting Bind calls,
+// short-circuiting to Nothing as soon as any step is empty.
+type DoMaybe[T any] struct {
+	scope   Scope
+	isEmpty bool
+}
+
+// NewDoMaybe starts a new DoMaybe chain with an empty Scope.
+func NewDoMaybe[T any]() *DoMaybe[T] {
+	return &DoMaybe[T]{scope: Scope{}}
+}
+
+// Bind runs f against the Scope accumulated so far and stores its result
+// under name, unless an earlier step has already come up empty.
+func (d *DoMaybe[T]) Bind(name string, f func(Scope) maybepkg.Maybe[any]) *DoMaybe[T] {
+	if d.isEmpty {
+		return d
+	}
+	m := f(d.scope)
+	if m.IsNothing() {
+		d.isEmpty = true
+		return d
+	}
+	d.scope[name] = m.Get()
+	return d
+}
+
+// Return finishes the chain, applying f to the final Scope if every step
+// produced a value, or Nothing otherwise.
+func (d *DoMaybe[T]) Return(f func(Scope) T) maybepkg.Maybe[T] {
+	if d.isEmpty {
+		return maybepkg.Nothing[T]()
+	}
+	return maybepkg.Just(f(d.scope))
+}
+
+// DoEither sequences Either-producing steps without nesting BindRight
+// calls, short-circuiting on the first Left.
+type DoEither[A any, T any] struct {
+	scope  Scope
+	left   A
+	failed bool
+}
+
+// NewDoEither starts a new DoEither chain with an empty Scope.
+func NewDoEither[A any, T any]() *DoEither[A, T] {
+	return &DoEither[A, T]{scope: Scope{}}
+}
+
+// Bind runs f against the Scope accumulated so far and stores its Right
+// value under name, unless an earlier step has already failed.
+func (d *DoEither[A, T]) Bind(name string, f func(Scope) Either[A, any]) *DoEither[A, T] {
+	if d.failed {
+		return d
+	}
+	e := f(d.scope)
+	if e.IsLeft() {
+		d.left = e.Left()
+		d.failed = true
+		return d
+	}
+	d.scope[name] = e.Right()
+	return d
+}
+
+// Return finishes the chain, applying f to the final Scope if every step
+// succeeded, or propagating the first Left encountered otherwise.
+func (d *DoEither[A, T]) Return(f func(Scope) T) Either[A, T] {
+	if d.failed {
+		return Left[A, T](d.left)
+	}
+	return Right[A, T](f(d.scope))
+}
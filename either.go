@@ -1,5 +1,7 @@
 package gomonad
 
+import "github.com/gilramir/gomonad/maybepkg"
+
 // Either represents a value of one of two types.
 // A is usually the "Left" type, B is usually the "Right" type.
 type Either[A any, B any] struct {
@@ -71,3 +73,110 @@ func ToResult[T any](e Either[error, T]) Result[T] {
 	}
 	return Ok[T](e.right)
 }
+
+// BindRight chains operations that return another Either, short-circuiting
+// on Left. This is the standard "monadic bind" for Either.
+func BindRight[A any, B any, C any](e Either[A, B], f func(B) Either[A, C]) Either[A, C] {
+	if e.isLeft {
+		return Left[A, C](e.left)
+	}
+	return f(e.right)
+}
+
+// BindLeft chains operations that return another Either, short-circuiting
+// on Right. This is the symmetric counterpart to BindRight.
+func BindLeft[A any, B any, C any](e Either[A, B], f func(A) Either[C, B]) Either[C, B] {
+	if e.isLeft {
+		return f(e.left)
+	}
+	return Right[C, B](e.right)
+}
+
+// BiMap transforms both sides of an Either at once, applying f to Left and
+// g to Right.
+func BiMap[A any, B any, C any, D any](e Either[A, B], f func(A) C, g func(B) D) Either[C, D] {
+	if e.isLeft {
+		return Left[C, D](f(e.left))
+	}
+	return Right[C, D](g(e.right))
+}
+
+// FromResult converts a Result into an Either, with the error (if any) on
+// the Left.
+func FromResult[T any](r Result[T]) Either[error, T] {
+	if r.IsErr() {
+		return Left[error, T](r.GetErr())
+	}
+	return Right[error, T](r.Get())
+}
+
+// FromMaybe converts a Maybe into an Either, using leftIfNothing as the
+// Left value when the Maybe is empty.
+func FromMaybe[A any, B any](m maybepkg.Maybe[B], leftIfNothing A) Either[A, B] {
+	if m.IsNothing() {
+		return Left[A, B](leftIfNothing)
+	}
+	return Right[A, B](m.Get())
+}
+
+// ToMaybe converts an Either to a Maybe, discarding the Left value (if
+// any) and keeping only whether a Right was present.
+func ToMaybe[A any, B any](e Either[A, B]) maybepkg.Maybe[B] {
+	if e.isLeft {
+		return maybepkg.Nothing[B]()
+	}
+	return maybepkg.Just(e.right)
+}
+
+// GetOrElse returns the Right value, or default if the Either is Left.
+func (e Either[A, B]) GetOrElse(def B) B {
+	if e.isLeft {
+		return def
+	}
+	return e.right
+}
+
+// OrElse returns e if it is Right, or alt otherwise.
+func (e Either[A, B]) OrElse(alt Either[A, B]) Either[A, B] {
+	if e.isLeft {
+		return alt
+	}
+	return e
+}
+
+// WhenLeft invokes f with the Left value, if present.
+func (e Either[A, B]) WhenLeft(f func(A)) {
+	if e.isLeft {
+		f(e.left)
+	}
+}
+
+// WhenRight invokes f with the Right value, if present.
+func (e Either[A, B]) WhenRight(f func(B)) {
+	if !e.isLeft {
+		f(e.right)
+	}
+}
+
+// Sequence turns a slice of Eithers into an Either of a slice, short-
+// circuiting on the first Left encountered.
+func Sequence[A any, B any](es []Either[A, B]) Either[A, []B] {
+	out := make([]B, len(es))
+	for i, e := range es {
+		if e.isLeft {
+			return Left[A, []B](e.left)
+		}
+		out[i] = e.right
+	}
+	return Right[A, []B](out)
+}
+
+// Traverse maps f over xs and collects the Eithers as Sequence does,
+// short-circuiting on the first Left encountered.
+func Traverse[A any, X any, B any](xs []X, f func(X) Either[A, B]) Either[A, []B] {
+	out := make([]Either[A, B], len(xs))
+	for i, x := range xs {
+		out[i] = f(x)
+	}
+	return Sequence(out)
+}
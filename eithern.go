@@ -0,0 +1,351 @@
+package gomonad
+
+// eitherTag discriminates which arm of an EitherN is populated, following
+// the same tagged-union layout as Either itself.
+type eitherTag int
+
+const (
+	argTag1 eitherTag = iota
+	argTag2
+	argTag3
+	argTag4
+	argTag5
+)
+
+// Either3 represents a value that is one of three possible types. It
+// generalizes Either to closed sum types with more than two arms.
+type Either3[A any, B any, C any] struct {
+	arg1 A
+	arg2 B
+	arg3 C
+	tag  eitherTag
+}
+
+// NewArg1Of3 creates an Either3 populated with its first arm.
+func NewArg1Of3[A any, B any, C any](val A) Either3[A, B, C] {
+	return Either3[A, B, C]{arg1: val, tag: argTag1}
+}
+
+// NewArg2Of3 creates an Either3 populated with its second arm.
+func NewArg2Of3[A any, B any, C any](val B) Either3[A, B, C] {
+	return Either3[A, B, C]{arg2: val, tag: argTag2}
+}
+
+// NewArg3Of3 creates an Either3 populated with its third arm.
+func NewArg3Of3[A any, B any, C any](val C) Either3[A, B, C] {
+	return Either3[A, B, C]{arg3: val, tag: argTag3}
+}
+
+func (e Either3[A, B, C]) IsArg1() bool { return e.tag == argTag1 }
+func (e Either3[A, B, C]) IsArg2() bool { return e.tag == argTag2 }
+func (e Either3[A, B, C]) IsArg3() bool { return e.tag == argTag3 }
+
+// ElimEither3 is the universal eliminator for Either3: it applies the
+// continuation matching the populated arm and returns its result.
+func ElimEither3[A any, B any, C any, T any](e Either3[A, B, C], f1 func(A) T, f2 func(B) T, f3 func(C) T) T {
+	switch e.tag {
+	case argTag1:
+		return f1(e.arg1)
+	case argTag2:
+		return f2(e.arg2)
+	default:
+		return f3(e.arg3)
+	}
+}
+
+// Fold3 reduces an Either3 to a single value, same as ElimEither3. It is
+// provided under the Fold name for parity with Either's Fold.
+func Fold3[A any, B any, C any, T any](e Either3[A, B, C], f1 func(A) T, f2 func(B) T, f3 func(C) T) T {
+	return ElimEither3(e, f1, f2, f3)
+}
+
+// MapArg1Of3 transforms the first arm, leaving the others untouched.
+func MapArg1Of3[A any, B any, C any, D any](e Either3[A, B, C], f func(A) D) Either3[D, B, C] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of3[D, B, C](f(e.arg1))
+	case argTag2:
+		return NewArg2Of3[D, B, C](e.arg2)
+	default:
+		return NewArg3Of3[D, B, C](e.arg3)
+	}
+}
+
+// MapArg2Of3 transforms the second arm, leaving the others untouched.
+func MapArg2Of3[A any, B any, C any, D any](e Either3[A, B, C], f func(B) D) Either3[A, D, C] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of3[A, D, C](e.arg1)
+	case argTag2:
+		return NewArg2Of3[A, D, C](f(e.arg2))
+	default:
+		return NewArg3Of3[A, D, C](e.arg3)
+	}
+}
+
+// MapArg3Of3 transforms the third arm, leaving the others untouched.
+func MapArg3Of3[A any, B any, C any, D any](e Either3[A, B, C], f func(C) D) Either3[A, B, D] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of3[A, B, D](e.arg1)
+	case argTag2:
+		return NewArg2Of3[A, B, D](e.arg2)
+	default:
+		return NewArg3Of3[A, B, D](f(e.arg3))
+	}
+}
+
+// Either4 represents a value that is one of four possible types.
+type Either4[A any, B any, C any, D any] struct {
+	arg1 A
+	arg2 B
+	arg3 C
+	arg4 D
+	tag  eitherTag
+}
+
+// NewArg1Of4 creates an Either4 populated with its first arm.
+func NewArg1Of4[A any, B any, C any, D any](val A) Either4[A, B, C, D] {
+	return Either4[A, B, C, D]{arg1: val, tag: argTag1}
+}
+
+// NewArg2Of4 creates an Either4 populated with its second arm.
+func NewArg2Of4[A any, B any, C any, D any](val B) Either4[A, B, C, D] {
+	return Either4[A, B, C, D]{arg2: val, tag: argTag2}
+}
+
+// NewArg3Of4 creates an Either4 populated with its third arm.
+func NewArg3Of4[A any, B any, C any, D any](val C) Either4[A, B, C, D] {
+	return Either4[A, B, C, D]{arg3: val, tag: argTag3}
+}
+
+// NewArg4Of4 creates an Either4 populated with its fourth arm.
+func NewArg4Of4[A any, B any, C any, D any](val D) Either4[A, B, C, D] {
+	return Either4[A, B, C, D]{arg4: val, tag: argTag4}
+}
+
+func (e Either4[A, B, C, D]) IsArg1() bool { return e.tag == argTag1 }
+func (e Either4[A, B, C, D]) IsArg2() bool { return e.tag == argTag2 }
+func (e Either4[A, B, C, D]) IsArg3() bool { return e.tag == argTag3 }
+func (e Either4[A, B, C, D]) IsArg4() bool { return e.tag == argTag4 }
+
+// ElimEither4 is the universal eliminator for Either4: it applies the
+// continuation matching the populated arm and returns its result.
+func ElimEither4[A any, B any, C any, D any, T any](e Either4[A, B, C, D], f1 func(A) T, f2 func(B) T, f3 func(C) T, f4 func(D) T) T {
+	switch e.tag {
+	case argTag1:
+		return f1(e.arg1)
+	case argTag2:
+		return f2(e.arg2)
+	case argTag3:
+		return f3(e.arg3)
+	default:
+		return f4(e.arg4)
+	}
+}
+
+// Fold4 reduces an Either4 to a single value, same as ElimEither4. It is
+// provided under the Fold name for parity with Either's Fold.
+func Fold4[A any, B any, C any, D any, T any](e Either4[A, B, C, D], f1 func(A) T, f2 func(B) T, f3 func(C) T, f4 func(D) T) T {
+	return ElimEither4(e, f1, f2, f3, f4)
+}
+
+// MapArg1Of4 transforms the first arm, leaving the others untouched.
+func MapArg1Of4[A any, B any, C any, D any, E any](e Either4[A, B, C, D], f func(A) E) Either4[E, B, C, D] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of4[E, B, C, D](f(e.arg1))
+	case argTag2:
+		return NewArg2Of4[E, B, C, D](e.arg2)
+	case argTag3:
+		return NewArg3Of4[E, B, C, D](e.arg3)
+	default:
+		return NewArg4Of4[E, B, C, D](e.arg4)
+	}
+}
+
+// MapArg2Of4 transforms the second arm, leaving the others untouched.
+func MapArg2Of4[A any, B any, C any, D any, E any](e Either4[A, B, C, D], f func(B) E) Either4[A, E, C, D] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of4[A, E, C, D](e.arg1)
+	case argTag2:
+		return NewArg2Of4[A, E, C, D](f(e.arg2))
+	case argTag3:
+		return NewArg3Of4[A, E, C, D](e.arg3)
+	default:
+		return NewArg4Of4[A, E, C, D](e.arg4)
+	}
+}
+
+// MapArg3Of4 transforms the third arm, leaving the others untouched.
+func MapArg3Of4[A any, B any, C any, D any, E any](e Either4[A, B, C, D], f func(C) E) Either4[A, B, E, D] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of4[A, B, E, D](e.arg1)
+	case argTag2:
+		return NewArg2Of4[A, B, E, D](e.arg2)
+	case argTag3:
+		return NewArg3Of4[A, B, E, D](f(e.arg3))
+	default:
+		return NewArg4Of4[A, B, E, D](e.arg4)
+	}
+}
+
+// MapArg4Of4 transforms the fourth arm, leaving the others untouched.
+func MapArg4Of4[A any, B any, C any, D any, E any](e Either4[A, B, C, D], f func(D) E) Either4[A, B, C, E] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of4[A, B, C, E](e.arg1)
+	case argTag2:
+		return NewArg2Of4[A, B, C, E](e.arg2)
+	case argTag3:
+		return NewArg3Of4[A, B, C, E](e.arg3)
+	default:
+		return NewArg4Of4[A, B, C, E](f(e.arg4))
+	}
+}
+
+// Either5 represents a value that is one of five possible types.
+type Either5[A any, B any, C any, D any, E any] struct {
+	arg1 A
+	arg2 B
+	arg3 C
+	arg4 D
+	arg5 E
+	tag  eitherTag
+}
+
+// NewArg1Of5 creates an Either5 populated with its first arm.
+func NewArg1Of5[A any, B any, C any, D any, E any](val A) Either5[A, B, C, D, E] {
+	return Either5[A, B, C, D, E]{arg1: val, tag: argTag1}
+}
+
+// NewArg2Of5 creates an Either5 populated with its second arm.
+func NewArg2Of5[A any, B any, C any, D any, E any](val B) Either5[A, B, C, D, E] {
+	return Either5[A, B, C, D, E]{arg2: val, tag: argTag2}
+}
+
+// NewArg3Of5 creates an Either5 populated with its third arm.
+func NewArg3Of5[A any, B any, C any, D any, E any](val C) Either5[A, B, C, D, E] {
+	return Either5[A, B, C, D, E]{arg3: val, tag: argTag3}
+}
+
+// NewArg4Of5 creates an Either5 populated with its fourth arm.
+func NewArg4Of5[A any, B any, C any, D any, E any](val D) Either5[A, B, C, D, E] {
+	return Either5[A, B, C, D, E]{arg4: val, tag: argTag4}
+}
+
+// NewArg5Of5 creates an Either5 populated with its fifth arm.
+func NewArg5Of5[A any, B any, C any, D any, E any](val E) Either5[A, B, C, D, E] {
+	return Either5[A, B, C, D, E]{arg5: val, tag: argTag5}
+}
+
+func (e Either5[A, B, C, D, E]) IsArg1() bool { return e.tag == argTag1 }
+func (e Either5[A, B, C, D, E]) IsArg2() bool { return e.tag == argTag2 }
+func (e Either5[A, B, C, D, E]) IsArg3() bool { return e.tag == argTag3 }
+func (e Either5[A, B, C, D, E]) IsArg4() bool { return e.tag == argTag4 }
+func (e Either5[A, B, C, D, E]) IsArg5() bool { return e.tag == argTag5 }
+
+// ElimEither5 is the universal eliminator for Either5: it applies the
+// continuation matching the populated arm and returns its result.
+func ElimEither5[A any, B any, C any, D any, E any, T any](e Either5[A, B, C, D, E], f1 func(A) T, f2 func(B) T, f3 func(C) T, f4 func(D) T, f5 func(E) T) T {
+	switch e.tag {
+	case argTag1:
+		return f1(e.arg1)
+	case argTag2:
+		return f2(e.arg2)
+	case argTag3:
+		return f3(e.arg3)
+	case argTag4:
+		return f4(e.arg4)
+	default:
+		return f5(e.arg5)
+	}
+}
+
+// Fold5 reduces an Either5 to a single value, same as ElimEither5. It is
+// provided under the Fold name for parity with Either's Fold.
+func Fold5[A any, B any, C any, D any, E any, T any](e Either5[A, B, C, D, E], f1 func(A) T, f2 func(B) T, f3 func(C) T, f4 func(D) T, f5 func(E) T) T {
+	return ElimEither5(e, f1, f2, f3, f4, f5)
+}
+
+// MapArg1Of5 transforms the first arm, leaving the others untouched.
+func MapArg1Of5[A any, B any, C any, D any, E any, F any](e Either5[A, B, C, D, E], f func(A) F) Either5[F, B, C, D, E] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of5[F, B, C, D, E](f(e.arg1))
+	case argTag2:
+		return NewArg2Of5[F, B, C, D, E](e.arg2)
+	case argTag3:
+		return NewArg3Of5[F, B, C, D, E](e.arg3)
+	case argTag4:
+		return NewArg4Of5[F, B, C, D, E](e.arg4)
+	default:
+		return NewArg5Of5[F, B, C, D, E](e.arg5)
+	}
+}
+
+// MapArg2Of5 transforms the second arm, leaving the others untouched.
+func MapArg2Of5[A any, B any, C any, D any, E any, F any](e Either5[A, B, C, D, E], f func(B) F) Either5[A, F, C, D, E] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of5[A, F, C, D, E](e.arg1)
+	case argTag2:
+		return NewArg2Of5[A, F, C, D, E](f(e.arg2))
+	case argTag3:
+		return NewArg3Of5[A, F, C, D, E](e.arg3)
+	case argTag4:
+		return NewArg4Of5[A, F, C, D, E](e.arg4)
+	default:
+		return NewArg5Of5[A, F, C, D, E](e.arg5)
+	}
+}
+
+// MapArg3Of5 transforms the third arm, leaving the others untouched.
+func MapArg3Of5[A any, B any, C any, D any, E any, F any](e Either5[A, B, C, D, E], f func(C) F) Either5[A, B, F, D, E] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of5[A, B, F, D, E](e.arg1)
+	case argTag2:
+		return NewArg2Of5[A, B, F, D, E](e.arg2)
+	case argTag3:
+		return NewArg3Of5[A, B, F, D, E](f(e.arg3))
+	case argTag4:
+		return NewArg4Of5[A, B, F, D, E](e.arg4)
+	default:
+		return NewArg5Of5[A, B, F, D, E](e.arg5)
+	}
+}
+
+// MapArg4Of5 transforms the fourth arm, leaving the others untouched.
+func MapArg4Of5[A any, B any, C any, D any, E any, F any](e Either5[A, B, C, D, E], f func(D) F) Either5[A, B, C, F, E] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of5[A, B, C, F, E](e.arg1)
+	case argTag2:
+		return NewArg2Of5[A, B, C, F, E](e.arg2)
+	case argTag3:
+		return NewArg3Of5[A, B, C, F, E](e.arg3)
+	case argTag4:
+		return NewArg4Of5[A, B, C, F, E](f(e.arg4))
+	default:
+		return NewArg5Of5[A, B, C, F, E](e.arg5)
+	}
+}
+
+// MapArg5Of5 transforms the fifth arm, leaving the others untouched.
+func MapArg5Of5[A any, B any, C any, D any, E any, F any](e Either5[A, B, C, D, E], f func(E) F) Either5[A, B, C, D, F] {
+	switch e.tag {
+	case argTag1:
+		return NewArg1Of5[A, B, C, D, F](e.arg1)
+	case argTag2:
+		return NewArg2Of5[A, B, C, D, F](e.arg2)
+	case argTag3:
+		return NewArg3Of5[A, B, C, D, F](e.arg3)
+	case argTag4:
+		return NewArg4Of5[A, B, C, D, F](e.arg4)
+	default:
+		return NewArg5Of5[A, B, C, D, F](f(e.arg5))
+	}
+}
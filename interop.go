@@ -0,0 +1,56 @@
+package gomonad
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FromErr converts an idiomatic (value, error) pair, as returned by most
+// Go APIs, into a Result.
+func FromErr[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// Tuple converts a Result back into an idiomatic (value, error) pair for
+// interop with APIs that expect one.
+func (r Result[T]) Tuple() (T, error) {
+	return r.value, r.err
+}
+
+// eitherJSON is the wire format for Either: exactly one of Left or Right
+// is present, matching whichever side is populated.
+type eitherJSON[A any, B any] struct {
+	Left  *A `json:"left,omitempty"`
+	Right *B `json:"right,omitempty"`
+}
+
+// MarshalJSON encodes Left as {"left": ...} and Right as {"right": ...}.
+func (e Either[A, B]) MarshalJSON() ([]byte, error) {
+	if e.isLeft {
+		return json.Marshal(eitherJSON[A, B]{Left: &e.left})
+	}
+	return json.Marshal(eitherJSON[A, B]{Right: &e.right})
+}
+
+// UnmarshalJSON decodes a {"left": ...} or {"right": ...} object back into
+// an Either.
+func (e *Either[A, B]) UnmarshalJSON(data []byte) error {
+	var wire eitherJSON[A, B]
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	switch {
+	case wire.Left != nil && wire.Right != nil:
+		return fmt.Errorf("gomonad: Either JSON must set exactly one of \"left\" or \"right\"")
+	case wire.Left != nil:
+		*e = Left[A, B](*wire.Left)
+	case wire.Right != nil:
+		*e = Right[A, B](*wire.Right)
+	default:
+		return fmt.Errorf("gomonad: Either JSON must set exactly one of \"left\" or \"right\"")
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package gomonad
+
+import (
+	"context"
+	"sync"
+)
+
+// IO represents a deferred, repeatable side-effecting computation.
+// Nothing happens until Run is called.
+type IO[T any] func() T
+
+// NewIO wraps a plain function as an IO.
+func NewIO[T any](f func() T) IO[T] {
+	return IO[T](f)
+}
+
+// Run executes the deferred computation and returns its result.
+func (io IO[T]) Run() T {
+	return io()
+}
+
+// MapIO transforms the result of an IO once it is run.
+func MapIO[T any, U any](io IO[T], f func(T) U) IO[U] {
+	return func() U {
+		return f(io.Run())
+	}
+}
+
+// BindIO chains an IO into another IO-producing function, deferring both.
+func BindIO[T any, U any](io IO[T], f func(T) IO[U]) IO[U] {
+	return func() U {
+		return f(io.Run()).Run()
+	}
+}
+
+// NewIOEither wraps an idiomatic (T, error) function as an IO[Result[T]]
+// (an "IOEither"): a deferred computation whose outcome may fail.
+func NewIOEither[T any](f func() (T, error)) IO[Result[T]] {
+	return func() Result[T] {
+		v, err := f()
+		if err != nil {
+			return Err[T](err)
+		}
+		return Ok(v)
+	}
+}
+
+// BindIOEither chains a fallible deferred step onto another, short-circuiting
+// on the first error.
+func BindIOEither[T any, U any](io IO[Result[T]], f func(T) IO[Result[U]]) IO[Result[U]] {
+	return func() Result[U] {
+		r := io.Run()
+		if r.IsErr() {
+			return Err[U](r.GetErr())
+		}
+		return f(r.Get()).Run()
+	}
+}
+
+// Task represents an asynchronous computation that runs in its own
+// goroutine and can be cancelled via a context.Context.
+type Task[T any] struct {
+	start func(ctx context.Context) <-chan T
+}
+
+// NewTask returns a Task that, on each Await, starts f in a new goroutine
+// and waits for it to finish or ctx to be done, whichever comes first.
+func NewTask[T any](f func(ctx context.Context) T) Task[T] {
+	return Task[T]{
+		start: func(ctx context.Context) <-chan T {
+			out := make(chan T, 1)
+			go func() {
+				out <- f(ctx)
+			}()
+			return out
+		},
+	}
+}
+
+// Await blocks until the Task completes or ctx is done, whichever comes
+// first. If ctx is done first, it returns the zero value and ctx.Err().
+func (t Task[T]) Await(ctx context.Context) (T, error) {
+	ch := t.start(ctx)
+	select {
+	case v := <-ch:
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// MapTask transforms the result of a Task once it resolves. If ctx is
+// done before the Task resolves, the zero value is returned without
+// invoking f, since a plain Task has no error channel to carry the
+// cancellation through.
+func MapTask[T any, U any](t Task[T], f func(T) U) Task[U] {
+	return NewTask(func(ctx context.Context) U {
+		v, err := t.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero
+		}
+		return f(v)
+	})
+}
+
+// BindTask chains a Task into another Task-producing function. If ctx is
+// done before either Task resolves, the zero value is returned without
+// invoking f, since a plain Task has no error channel to carry the
+// cancellation through.
+func BindTask[T any, U any](t Task[T], f func(T) Task[U]) Task[U] {
+	return NewTask(func(ctx context.Context) U {
+		v, err := t.Await(ctx)
+		if err != nil {
+			var zero U
+			return zero
+		}
+		u, err := f(v).Await(ctx)
+		if err != nil {
+			var zero U
+			return zero
+		}
+		return u
+	})
+}
+
+// NewTaskEither wraps an idiomatic (T, error) function as a
+// Task[Result[T]] (a "TaskEither"): an asynchronous computation whose
+// outcome may fail. Like NewTask, f is not started until Await.
+func NewTaskEither[T any](f func(ctx context.Context) (T, error)) Task[Result[T]] {
+	return NewTask(func(ctx context.Context) Result[T] {
+		v, err := f(ctx)
+		if err != nil {
+			return Err[T](err)
+		}
+		return Ok(v)
+	})
+}
+
+// BindTaskEither chains a fallible async step onto another, short-circuiting
+// on the first error (including context cancellation).
+func BindTaskEither[T any, U any](t Task[Result[T]], f func(T) Task[Result[U]]) Task[Result[U]] {
+	return NewTask(func(ctx context.Context) Result[U] {
+		r, err := t.Await(ctx)
+		if err != nil {
+			return Err[U](err)
+		}
+		if r.IsErr() {
+			return Err[U](r.GetErr())
+		}
+		next, err := f(r.Get()).Await(ctx)
+		if err != nil {
+			return Err[U](err)
+		}
+		return next
+	})
+}
+
+// Parallel runs several TaskEithers (Task[Result[T]]) concurrently, waiting
+// for all of them to settle, and collects their Results in the original
+// order.
+func Parallel[T any](ctx context.Context, tasks []Task[Result[T]]) []Result[T] {
+	results := make([]Result[T], len(tasks))
+	var wg sync.WaitGroup
+	wg.Add(len(tasks))
+	for i, t := range tasks {
+		go func(i int, t Task[Result[T]]) {
+			defer wg.Done()
+			r, err := t.Await(ctx)
+			if err != nil {
+				results[i] = Err[T](err)
+				return
+			}
+			results[i] = r
+		}(i, t)
+	}
+	wg.Wait()
+	return results
+}
+
+// SequenceTask runs tasks concurrently like Parallel, then collapses the
+// Results into a single Result[[]T], short-circuiting on the first error.
+func SequenceTask[T any](ctx context.Context, tasks []Task[Result[T]]) Result[[]T] {
+	results := Parallel(ctx, tasks)
+	out := make([]T, len(results))
+	for i, r := range results {
+		if r.IsErr() {
+			return Err[[]T](r.GetErr())
+		}
+		out[i] = r.Get()
+	}
+	return Ok(out)
+}
+
+// TraverseTask maps f over xs, runs every resulting TaskEither concurrently,
+// and collects the Results as SequenceTask does.
+func TraverseTask[A any, B any](ctx context.Context, xs []A, f func(A) Task[Result[B]]) Result[[]B] {
+	tasks := make([]Task[Result[B]], len(xs))
+	for i, x := range xs {
+		tasks[i] = f(x)
+	}
+	return SequenceTask(ctx, tasks)
+}
+
+// Future represents an asynchronous computation that has already been
+// started and whose result is memoized for every caller that awaits it.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+}
+
+// NewFuture starts f immediately in its own goroutine.
+func NewFuture[T any](f func() T) *Future[T] {
+	fut := &Future[T]{done: make(chan struct{})}
+	go func() {
+		fut.value = f()
+		close(fut.done)
+	}()
+	return fut
+}
+
+// Await blocks until the Future resolves or ctx is done, whichever comes
+// first. Unlike Task.Await, calling Await again returns the same memoized
+// value instead of starting a new computation.
+func (fut *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-fut.done:
+		return fut.value, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
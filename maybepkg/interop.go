@@ -0,0 +1,80 @@
+package maybepkg
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// FromTuple converts an idiomatic (value, ok bool) pair, as returned by a
+// map lookup or a type assertion, into a Maybe.
+func FromTuple[T any](v T, ok bool) Maybe[T] {
+	if !ok {
+		return Nothing[T]()
+	}
+	return Just(v)
+}
+
+// MarshalJSON encodes Just as the wrapped value and Nothing as JSON null.
+func (m Maybe[T]) MarshalJSON() ([]byte, error) {
+	if !m.isJust {
+		return []byte("null"), nil
+	}
+	return json.Marshal(m.value)
+}
+
+// UnmarshalJSON decodes JSON null as Nothing and anything else as Just of
+// the decoded value.
+func (m *Maybe[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*m = Nothing[T]()
+		return nil
+	}
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	*m = Just(val)
+	return nil
+}
+
+// Value implements driver.Valuer so a Maybe[T] can be used directly as a
+// nullable column value: Nothing maps to SQL NULL, Just maps to its
+// wrapped value. The value is run through the default parameter
+// converter (the same one database/sql applies to plain args) since T
+// is usually not already one of the handful of types driver.Value
+// allows, e.g. int needs to become int64.
+func (m Maybe[T]) Value() (driver.Value, error) {
+	if !m.isJust {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(m.value)
+}
+
+// Scan implements sql.Scanner so a Maybe[T] can be populated directly
+// from a nullable column: SQL NULL maps to Nothing, anything else is
+// converted into T. A direct type assertion is tried first; since
+// database/sql drivers only hand Scan one of a handful of base types
+// (int64, float64, bool, []byte, string, time.Time), a reflect-based
+// conversion covers the common case of T being, say, int or a named
+// string type rather than int64 or string exactly.
+func (m *Maybe[T]) Scan(src any) error {
+	if src == nil {
+		*m = Nothing[T]()
+		return nil
+	}
+	if val, ok := src.(T); ok {
+		*m = Just(val)
+		return nil
+	}
+	var val T
+	srcVal := reflect.ValueOf(src)
+	dstType := reflect.TypeOf(val)
+	if dstType != nil && srcVal.Type().ConvertibleTo(dstType) {
+		reflect.ValueOf(&val).Elem().Set(srcVal.Convert(dstType))
+		*m = Just(val)
+		return nil
+	}
+	return fmt.Errorf("maybepkg: cannot scan %T into Maybe[%T]", src, val)
+}
@@ -55,3 +55,26 @@ func (m Maybe[T]) IsNothing() bool {
 func (m Maybe[T]) Get() T {
 	return m.value
 }
+
+// Sequence turns a slice of Maybes into a Maybe of a slice, short-
+// circuiting to Nothing on the first Nothing encountered.
+func Sequence[T any](ms []Maybe[T]) Maybe[[]T] {
+	out := make([]T, len(ms))
+	for i, m := range ms {
+		if !m.isJust {
+			return Nothing[[]T]()
+		}
+		out[i] = m.value
+	}
+	return Just(out)
+}
+
+// Traverse maps f over xs and collects the Maybes as Sequence does,
+// short-circuiting to Nothing on the first Nothing encountered.
+func Traverse[A any, B any](xs []A, f func(A) Maybe[B]) Maybe[[]B] {
+	out := make([]Maybe[B], len(xs))
+	for i, x := range xs {
+		out[i] = f(x)
+	}
+	return Sequence(out)
+}
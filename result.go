@@ -41,3 +41,26 @@ func MapResult[T any, U any](r Result[T], f func(T) U) Result[U] {
 	}
 	return Ok[U](f(r.value))
 }
+
+// SequenceResult turns a slice of Results into a Result of a slice,
+// short-circuiting on the first error encountered.
+func SequenceResult[T any](rs []Result[T]) Result[[]T] {
+	out := make([]T, len(rs))
+	for i, r := range rs {
+		if r.isError {
+			return Err[[]T](r.err)
+		}
+		out[i] = r.value
+	}
+	return Ok(out)
+}
+
+// TraverseResult maps f over xs and collects the Results as SequenceResult
+// does, short-circuiting on the first error.
+func TraverseResult[A any, B any](xs []A, f func(A) Result[B]) Result[[]B] {
+	out := make([]Result[B], len(xs))
+	for i, x := range xs {
+		out[i] = f(x)
+	}
+	return SequenceResult(out)
+}
@@ -0,0 +1,120 @@
+// Package statepkg provides the State monad, which threads a logical
+// state value through a chain of pure functions instead of passing a
+// pointer around.
+package statepkg
+
+import "github.com/gilramir/gomonad"
+
+// State represents a computation that, given an initial state S, produces
+// a result A and a (possibly updated) state S.
+type State[S any, A any] func(S) (A, S)
+
+// Return lifts a plain value into a State that leaves the state untouched.
+func Return[S any, A any](a A) State[S, A] {
+	return func(s S) (A, S) {
+		return a, s
+	}
+}
+
+// Get returns a State that yields the current state as its result, leaving
+// the state itself unchanged.
+func Get[S any]() State[S, S] {
+	return func(s S) (S, S) {
+		return s, s
+	}
+}
+
+// Put replaces the current state, yielding no meaningful result.
+func Put[S any](s S) State[S, struct{}] {
+	return func(S) (struct{}, S) {
+		return struct{}{}, s
+	}
+}
+
+// Modify applies f to the current state and stores the result.
+func Modify[S any](f func(S) S) State[S, struct{}] {
+	return func(s S) (struct{}, S) {
+		return struct{}{}, f(s)
+	}
+}
+
+// Run executes the State computation against an initial state, returning
+// both the result and the final state.
+func (m State[S, A]) Run(initial S) (A, S) {
+	return m(initial)
+}
+
+// Evaluate runs the State computation and discards the final state,
+// keeping only the result.
+func (m State[S, A]) Evaluate(initial S) A {
+	a, _ := m(initial)
+	return a
+}
+
+// Execute runs the State computation and discards the result, keeping
+// only the final state.
+func (m State[S, A]) Execute(initial S) S {
+	_, s := m(initial)
+	return s
+}
+
+// Map transforms the result of a State computation without touching the
+// state it threads through.
+func Map[S any, A any, B any](m State[S, A], f func(A) B) State[S, B] {
+	return func(s S) (B, S) {
+		a, s2 := m(s)
+		return f(a), s2
+	}
+}
+
+// Bind chains a State computation into another State-producing function,
+// threading the state from one step to the next.
+func Bind[S any, A any, B any](m State[S, A], f func(A) State[S, B]) State[S, B] {
+	return func(s S) (B, S) {
+		a, s2 := m(s)
+		return f(a)(s2)
+	}
+}
+
+// StateResult is a StateT-style variant of State that also short-circuits
+// on error, for computations that both thread state and may fail.
+type StateResult[S any, A any] func(S) (gomonad.Result[A], S)
+
+// ReturnResult lifts a plain value into a StateResult that succeeds and
+// leaves the state untouched.
+func ReturnResult[S any, A any](a A) StateResult[S, A] {
+	return func(s S) (gomonad.Result[A], S) {
+		return gomonad.Ok(a), s
+	}
+}
+
+// Run executes the StateResult computation against an initial state,
+// returning the Result and the final state.
+func (m StateResult[S, A]) Run(initial S) (gomonad.Result[A], S) {
+	return m(initial)
+}
+
+// BindResult chains a StateResult into another StateResult-producing
+// function, threading the state and short-circuiting as soon as either
+// step returns an error.
+func BindResult[S any, A any, B any](m StateResult[S, A], f func(A) StateResult[S, B]) StateResult[S, B] {
+	return func(s S) (gomonad.Result[B], S) {
+		r, s2 := m(s)
+		if r.IsErr() {
+			return gomonad.Err[B](r.GetErr()), s2
+		}
+		return f(r.Get())(s2)
+	}
+}
+
+// MapResult transforms the successful result of a StateResult computation,
+// leaving an already-failed computation untouched.
+func MapResult[S any, A any, B any](m StateResult[S, A], f func(A) B) StateResult[S, B] {
+	return func(s S) (gomonad.Result[B], S) {
+		r, s2 := m(s)
+		if r.IsErr() {
+			return gomonad.Err[B](r.GetErr()), s2
+		}
+		return gomonad.Ok(f(r.Get())), s2
+	}
+}
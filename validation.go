@@ -0,0 +1,143 @@
+package gomonad
+
+// Validation is a right-biased Either whose Left side is a slice of
+// errors that accumulates rather than short-circuits. It is meant for
+// form/config validation, where callers want every failure reported at
+// once instead of only the first.
+type Validation[E any, T any] struct {
+	errs    []E
+	value   T
+	isValid bool
+}
+
+// Valid wraps a successful value.
+func Valid[E any, T any](val T) Validation[E, T] {
+	return Validation[E, T]{value: val, isValid: true}
+}
+
+// Invalid wraps one or more accumulated errors.
+func Invalid[E any, T any](errs ...E) Validation[E, T] {
+	return Validation[E, T]{errs: errs, isValid: false}
+}
+
+func (v Validation[E, T]) IsValid() bool   { return v.isValid }
+func (v Validation[E, T]) IsInvalid() bool { return !v.isValid }
+func (v Validation[E, T]) Get() T          { return v.value }
+func (v Validation[E, T]) Errors() []E     { return v.errs }
+
+// ValidationFromResult converts a Result into a Validation, wrapping the
+// error (if any) as the sole accumulated failure.
+func ValidationFromResult[T any](r Result[T]) Validation[error, T] {
+	if r.IsErr() {
+		return Invalid[error, T](r.GetErr())
+	}
+	return Valid[error, T](r.Get())
+}
+
+// MapValidation transforms the value of a valid Validation, leaving an
+// already-invalid Validation's errors untouched.
+func MapValidation[E any, T any, U any](v Validation[E, T], f func(T) U) Validation[E, U] {
+	if !v.isValid {
+		return Invalid[E, U](v.errs...)
+	}
+	return Valid[E, U](f(v.value))
+}
+
+// Tuple2 holds the results of two independently-validated values.
+type Tuple2[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Tuple3 holds the results of three independently-validated values.
+type Tuple3[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Tuple4 holds the results of four independently-validated values.
+type Tuple4[A any, B any, C any, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Tuple5 holds the results of five independently-validated values.
+type Tuple5[A any, B any, C any, D any, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// Zip2 combines two independent Validations into a Tuple2, accumulating
+// the errors from both sides rather than stopping at the first.
+func Zip2[E any, A any, B any](a Validation[E, A], b Validation[E, B]) Validation[E, Tuple2[A, B]] {
+	errs := append(append([]E{}, a.errs...), b.errs...)
+	if len(errs) > 0 {
+		return Invalid[E, Tuple2[A, B]](errs...)
+	}
+	return Valid[E, Tuple2[A, B]](Tuple2[A, B]{a.value, b.value})
+}
+
+// Zip3 combines three independent Validations into a Tuple3, accumulating
+// every error across all of them.
+func Zip3[E any, A any, B any, C any](a Validation[E, A], b Validation[E, B], c Validation[E, C]) Validation[E, Tuple3[A, B, C]] {
+	errs := append(append(append([]E{}, a.errs...), b.errs...), c.errs...)
+	if len(errs) > 0 {
+		return Invalid[E, Tuple3[A, B, C]](errs...)
+	}
+	return Valid[E, Tuple3[A, B, C]](Tuple3[A, B, C]{a.value, b.value, c.value})
+}
+
+// Zip4 combines four independent Validations into a Tuple4, accumulating
+// every error across all of them.
+func Zip4[E any, A any, B any, C any, D any](a Validation[E, A], b Validation[E, B], c Validation[E, C], d Validation[E, D]) Validation[E, Tuple4[A, B, C, D]] {
+	errs := append(append(append(append([]E{}, a.errs...), b.errs...), c.errs...), d.errs...)
+	if len(errs) > 0 {
+		return Invalid[E, Tuple4[A, B, C, D]](errs...)
+	}
+	return Valid[E, Tuple4[A, B, C, D]](Tuple4[A, B, C, D]{a.value, b.value, c.value, d.value})
+}
+
+// Zip5 combines five independent Validations into a Tuple5, accumulating
+// every error across all of them.
+func Zip5[E any, A any, B any, C any, D any, F any](a Validation[E, A], b Validation[E, B], c Validation[E, C], d Validation[E, D], e Validation[E, F]) Validation[E, Tuple5[A, B, C, D, F]] {
+	errs := append(append(append(append(append([]E{}, a.errs...), b.errs...), c.errs...), d.errs...), e.errs...)
+	if len(errs) > 0 {
+		return Invalid[E, Tuple5[A, B, C, D, F]](errs...)
+	}
+	return Valid[E, Tuple5[A, B, C, D, F]](Tuple5[A, B, C, D, F]{a.value, b.value, c.value, d.value, e.value})
+}
+
+// SequenceValidation turns a slice of Validations into a Validation of a
+// slice, accumulating every error across all of them rather than
+// short-circuiting on the first.
+func SequenceValidation[E any, T any](vs []Validation[E, T]) Validation[E, []T] {
+	var errs []E
+	out := make([]T, len(vs))
+	for i, v := range vs {
+		if !v.isValid {
+			errs = append(errs, v.errs...)
+			continue
+		}
+		out[i] = v.value
+	}
+	if len(errs) > 0 {
+		return Invalid[E, []T](errs...)
+	}
+	return Valid[E, []T](out)
+}
+
+// TraverseValidation maps f over xs and gathers every failure as
+// SequenceValidation does.
+func TraverseValidation[A any, E any, T any](xs []A, f func(A) Validation[E, T]) Validation[E, []T] {
+	out := make([]Validation[E, T], len(xs))
+	for i, x := range xs {
+		out[i] = f(x)
+	}
+	return SequenceValidation(out)
+}